@@ -13,7 +13,8 @@ import (
 // #################
 
 type AccountRequest struct {
-	Timestamp time.Time
+	RecvWindow int // The value cannot be greater than 60000
+	Timestamp  time.Time
 }
 
 func (a AccountRequest) Validate() error {
@@ -21,6 +22,9 @@ func (a AccountRequest) Validate() error {
 }
 
 func (a AccountRequest) EmbedData(q *url.Values) {
+	if a.RecvWindow != 0 {
+		q.Set("recvWindow", strconv.Itoa(a.RecvWindow))
+	}
 	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(a.Timestamp)))
 }
 
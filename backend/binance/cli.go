@@ -9,6 +9,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 type Client struct {
@@ -16,10 +18,19 @@ type Client struct {
 	base      string
 	apiKey    string
 	secretKey []byte
+	limiter   *weightLimiter
+	filters   *filterCache
 }
 
 func NewBinance(h *http.Client, base string, apiKey string, secretKey []byte) *Client {
-	return &Client{h: h, base: base, apiKey: apiKey, secretKey: secretKey}
+	return &Client{
+		h:         h,
+		base:      base,
+		apiKey:    apiKey,
+		secretKey: secretKey,
+		limiter:   newWeightLimiter(),
+		filters:   newFilterCache(),
+	}
 }
 
 const (
@@ -30,6 +41,12 @@ const (
 	myTradesPath     = "api/v3/myTrades"
 	accountPath      = "api/v3/account"
 	allOrdersPath    = "api/v3/allOrders"
+
+	// weights are taken from the endpoint weight table published at
+	// https://github.com/binance/binance-spot-api-docs/blob/master/rest-api.md
+	myTradesWeight  = 10
+	accountWeight   = 10
+	allOrdersWeight = 10
 )
 
 func (c Client) Ping() {
@@ -57,8 +74,11 @@ recvWindow	LONG	NO			The value cannot be greater than 60000
 timestamp	LONG	YES
 */
 
-// MyTrades returns list of completed trades
-func (c Client) MyTrades(req MyTradesRequest) (*MyTradesResponse, error) {
+// MyTrades returns list of completed trades. opts can carry extra query
+// parameters (e.g. orderId) that have no dedicated field on
+// MyTradesRequest; they are embedded after req, so a key set by both takes
+// the opts value.
+func (c Client) MyTrades(req MyTradesRequest, opts ...OptionalParameter) (*MyTradesResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
@@ -69,15 +89,13 @@ func (c Client) MyTrades(req MyTradesRequest) (*MyTradesResponse, error) {
 		return nil, err
 	}
 
-	r, _ := http.NewRequest(http.MethodGet, c.createURL(req, parsedURL), nil)
-	r.Header.Set(apiKeyHeader, c.apiKey)
-
-	var trades MyTradesResponse
-
-	resp, err := c.h.Do(r)
+	resp, err := c.do(http.MethodGet, c.createURL(req, parsedURL, opts...), myTradesWeight)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+
+	var trades MyTradesResponse
 	if err := json.NewDecoder(resp.Body).Decode(&trades.Trades); err != nil {
 		return nil, err
 	}
@@ -96,15 +114,13 @@ func (c Client) Account(req AccountRequest) (*AccountResponse, error) {
 		return nil, err
 	}
 
-	r, _ := http.NewRequest(http.MethodGet, c.createURL(req, parsedURL), nil)
-	r.Header.Set(apiKeyHeader, c.apiKey)
-
-	var trades AccountResponse
-
-	resp, err := c.h.Do(r)
+	resp, err := c.do(http.MethodGet, c.createURL(req, parsedURL), accountWeight)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+
+	var trades AccountResponse
 	if err := json.NewDecoder(resp.Body).Decode(&trades); err != nil {
 		return nil, err
 	}
@@ -112,7 +128,9 @@ func (c Client) Account(req AccountRequest) (*AccountResponse, error) {
 	return &trades, nil
 }
 
-func (c Client) AllOrderList(req AllOrdersRequest) (*AllOrdersResponse, error) {
+// AllOrderList returns the account's order list history. opts can carry
+// extra query parameters the same way MyTrades does.
+func (c Client) AllOrderList(req AllOrdersRequest, opts ...OptionalParameter) (*AllOrdersResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
@@ -123,17 +141,13 @@ func (c Client) AllOrderList(req AllOrdersRequest) (*AllOrdersResponse, error) {
 		return nil, err
 	}
 
-	r, _ := http.NewRequest(http.MethodGet, c.createURL(req, parsedURL), nil)
-	r.Header.Set(apiKeyHeader, c.apiKey)
-
-	var orders AllOrdersResponse
-
-	resp, err := c.h.Do(r)
+	resp, err := c.do(http.MethodGet, c.createURL(req, parsedURL, opts...), allOrdersWeight)
 	if err != nil {
 		return nil, err
 	}
-	bo, _ := ioutil.ReadAll(resp.Body)
-	fmt.Println(string(bo))
+	defer resp.Body.Close()
+
+	var orders AllOrdersResponse
 	if err := json.NewDecoder(resp.Body).Decode(&orders.Orders); err != nil {
 		return nil, err
 	}
@@ -141,9 +155,12 @@ func (c Client) AllOrderList(req AllOrdersRequest) (*AllOrdersResponse, error) {
 	return &orders, nil
 }
 
-func (c Client) createURL(req RequestInterface, parsedURL *url.URL) string {
+func (c Client) createURL(req RequestInterface, parsedURL *url.URL, opts ...OptionalParameter) string {
 	q := &url.Values{}
 	req.EmbedData(q)
+	for _, opt := range opts {
+		opt.EmbedData(q)
+	}
 
 	h := hmac.New(sha256.New, c.secretKey)
 	h.Write([]byte(q.Encode()))
@@ -153,3 +170,52 @@ func (c Client) createURL(req RequestInterface, parsedURL *url.URL) string {
 
 	return parsedURL.String()
 }
+
+// do executes a signed request against the API, respecting the weight
+// limiter before sending and transparently retrying 5xx/429/418 responses
+// with backoff (honoring Retry-After when the server sends one). On every
+// response it reconciles the limiter against X-MBX-USED-WEIGHT-1M; on a non-2xx
+// response it decodes the {"code":..,"msg":..} envelope into a BinanceError
+// so callers can errors.As for specific codes (e.g. -1003, -1021, -2010).
+func (c Client) do(method, url string, weight int) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		c.limiter.wait(weight)
+
+		r, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("build request: %w", err)
+		}
+		r.Header.Set(apiKeyHeader, c.apiKey)
+
+		resp, err := c.h.Do(r)
+		if err != nil {
+			lastErr = err
+			time.Sleep(retryDelay(attempt, ""))
+			continue
+		}
+
+		if used, convErr := strconv.Atoi(resp.Header.Get(usedWeightHeader)); convErr == nil {
+			c.limiter.reconcile(used)
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, nil
+		}
+
+		var bErr BinanceError
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		_ = json.Unmarshal(body, &bErr)
+
+		if shouldRetry(resp.StatusCode) && attempt < maxRetries {
+			lastErr = bErr
+			time.Sleep(retryDelay(attempt, resp.Header.Get("Retry-After")))
+			continue
+		}
+
+		return nil, bErr
+	}
+
+	return nil, lastErr
+}
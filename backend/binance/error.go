@@ -1,6 +1,10 @@
 package binance
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/binanceBot/backend/binance/berror"
+)
 
 type BinanceError struct {
 	Code int    `json:"code"`
@@ -10,3 +14,11 @@ type BinanceError struct {
 func (b BinanceError) Error() string {
 	return fmt.Sprintf("%s, with %d code", b.Msg, b.Code)
 }
+
+// BinanceCliErr and ErrInvalidData are aliased from berror so request
+// validation code in this package (trades.go, kline.go, margin.go, ...) can
+// keep referring to them unqualified instead of importing berror in every
+// file.
+type BinanceCliErr = berror.BinanceCliErr
+
+var ErrInvalidData = berror.ErrInvalidData
@@ -0,0 +1,127 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// exchangeInfoFullResponse is the subset of api/v3/exchangeInfo needed to
+// build per-symbol tick size filters.
+type exchangeInfoFullResponse struct {
+	Symbols []struct {
+		Symbol  string `json:"symbol"`
+		Filters []struct {
+			FilterType  string          `json:"filterType"`
+			TickSize    decimal.Decimal `json:"tickSize"`
+			StepSize    decimal.Decimal `json:"stepSize"`
+			MinNotional decimal.Decimal `json:"minNotional"`
+		} `json:"filters"`
+	} `json:"symbols"`
+}
+
+// SymbolFilters holds the exchange-enforced precision rules for a symbol.
+type SymbolFilters struct {
+	Symbol         string
+	PriceTickSize  decimal.Decimal // smallest allowed increment for price, from the PRICE_FILTER.
+	AmountTickSize decimal.Decimal // smallest allowed increment for quantity, from the LOT_SIZE filter's stepSize.
+	MinNotional    decimal.Decimal // minimum order value (price * qty), from the MIN_NOTIONAL filter.
+}
+
+// filterCache holds SymbolFilters fetched via LoadExchangeInfo so RoundPrice
+// and RoundQty don't need a round trip per call.
+type filterCache struct {
+	mu      sync.RWMutex
+	filters map[string]SymbolFilters
+}
+
+func newFilterCache() *filterCache {
+	return &filterCache{filters: make(map[string]SymbolFilters)}
+}
+
+// get reports whether symbol has cached filters. A nil receiver (a Client
+// built as a bare struct literal instead of via NewBinance) behaves like an
+// empty cache rather than panicking.
+func (c *filterCache) get(symbol string) (SymbolFilters, bool) {
+	if c == nil {
+		return SymbolFilters{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	f, ok := c.filters[symbol]
+	return f, ok
+}
+
+// set caches f. A nil receiver is a no-op, same as get.
+func (c *filterCache) set(f SymbolFilters) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.filters[f.Symbol] = f
+}
+
+// LoadExchangeInfo fetches api/v3/exchangeInfo and populates the Client's
+// per-symbol SymbolFilters cache used by RoundPrice and RoundQty.
+func (c Client) LoadExchangeInfo() error {
+	u := fmt.Sprintf("%s/%s", c.base, exchangeInfoPath)
+	r, err := c.h.Get(u)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	var info exchangeInfoFullResponse
+	if err := json.NewDecoder(r.Body).Decode(&info); err != nil {
+		return err
+	}
+
+	for _, s := range info.Symbols {
+		f := SymbolFilters{Symbol: s.Symbol}
+		for _, filter := range s.Filters {
+			switch filter.FilterType {
+			case "PRICE_FILTER":
+				f.PriceTickSize = filter.TickSize
+			case "LOT_SIZE":
+				f.AmountTickSize = filter.StepSize
+			case "MIN_NOTIONAL":
+				f.MinNotional = filter.MinNotional
+			}
+		}
+		c.filters.set(f)
+	}
+	return nil
+}
+
+// RoundPrice snaps p down to symbol's price tick size. LoadExchangeInfo must
+// have been called for symbol beforehand.
+func (c Client) RoundPrice(symbol string, p decimal.Decimal) (decimal.Decimal, error) {
+	f, ok := c.filters.get(symbol)
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("binance: no filters cached for %s; call LoadExchangeInfo first", symbol)
+	}
+	return roundToStep(p, f.PriceTickSize), nil
+}
+
+// RoundQty snaps q down to symbol's quantity step size. LoadExchangeInfo
+// must have been called for symbol beforehand.
+func (c Client) RoundQty(symbol string, q decimal.Decimal) (decimal.Decimal, error) {
+	f, ok := c.filters.get(symbol)
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("binance: no filters cached for %s; call LoadExchangeInfo first", symbol)
+	}
+	return roundToStep(q, f.AmountTickSize), nil
+}
+
+// roundToStep truncates v down to the nearest multiple of step, which is
+// how Binance requires prices/quantities to be rounded rather than to a
+// fixed number of decimal places.
+func roundToStep(v, step decimal.Decimal) decimal.Decimal {
+	if step.IsZero() {
+		return v
+	}
+	return v.Div(step).Truncate(0).Mul(step)
+}
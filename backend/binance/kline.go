@@ -0,0 +1,210 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const klinesPath = "api/v3/klines"
+
+// klinesWeight covers a single klines call regardless of limit, per the
+// published weight table.
+const klinesWeight = 1
+
+// KlinePeriod is a candle interval accepted by the klines endpoint.
+type KlinePeriod string
+
+const (
+	Period1Minute  KlinePeriod = "1m"
+	Period3Minute  KlinePeriod = "3m"
+	Period5Minute  KlinePeriod = "5m"
+	Period15Minute KlinePeriod = "15m"
+	Period30Minute KlinePeriod = "30m"
+	Period1Hour    KlinePeriod = "1h"
+	Period4Hour    KlinePeriod = "4h"
+	Period1Day     KlinePeriod = "1d"
+	Period1Week    KlinePeriod = "1w"
+	Period1Month   KlinePeriod = "1M"
+)
+
+// KlinesRequest describes a klines/candlestick query.
+type KlinesRequest struct {
+	Symbol    string
+	Period    KlinePeriod
+	Limit     int // Default 500; max 1000.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (k KlinesRequest) Validate() error {
+	if k.Symbol == "" {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "symbol field is mandatory"}
+	}
+	if k.Period == "" {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "period field is mandatory"}
+	}
+	return nil
+}
+
+func (k KlinesRequest) EmbedData(q *url.Values) {
+	q.Set("symbol", k.Symbol)
+	q.Set("interval", string(k.Period))
+	if k.Limit != 0 {
+		q.Set("limit", strconv.Itoa(k.Limit))
+	}
+	if !k.StartTime.IsZero() {
+		q.Set("startTime", strconv.Itoa(timeToMilliseconds(k.StartTime)))
+	}
+	if !k.EndTime.IsZero() {
+		q.Set("endTime", strconv.Itoa(timeToMilliseconds(k.EndTime)))
+	}
+}
+
+// KlinesResponse holds the candles returned for a KlinesRequest.
+type KlinesResponse struct {
+	Klines []Kline
+}
+
+// Kline is a single OHLCV candle. Binance returns it as a heterogeneous JSON
+// array rather than an object, so UnmarshalJSON decodes it positionally.
+type Kline struct {
+	OpenTime                 time.Time
+	Open                     decimal.Decimal
+	High                     decimal.Decimal
+	Low                      decimal.Decimal
+	Close                    decimal.Decimal
+	Volume                   decimal.Decimal
+	CloseTime                time.Time
+	QuoteAssetVolume         decimal.Decimal
+	NumberOfTrades           int
+	TakerBuyBaseAssetVolume  decimal.Decimal
+	TakerBuyQuoteAssetVolume decimal.Decimal
+}
+
+// UnmarshalJSON decodes a single klines row, documented at
+// https://github.com/binance/binance-spot-api-docs/blob/master/rest-api.md#klinecandlestick-data
+func (k *Kline) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) < 11 {
+		return fmt.Errorf("binance: expected 11 kline fields, got %d", len(raw))
+	}
+
+	var openMs, closeMs int64
+	var numTrades int
+	var open, high, low, close_, volume, quoteVolume, takerBuyBase, takerBuyQuote decimal.Decimal
+
+	fields := []struct {
+		raw  json.RawMessage
+		dest interface{}
+	}{
+		{raw[0], &openMs},
+		{raw[1], &open},
+		{raw[2], &high},
+		{raw[3], &low},
+		{raw[4], &close_},
+		{raw[5], &volume},
+		{raw[6], &closeMs},
+		{raw[7], &quoteVolume},
+		{raw[8], &numTrades},
+		{raw[9], &takerBuyBase},
+		{raw[10], &takerBuyQuote},
+	}
+	for _, f := range fields {
+		if err := json.Unmarshal(f.raw, f.dest); err != nil {
+			return fmt.Errorf("binance: decode kline field: %w", err)
+		}
+	}
+
+	k.OpenTime = millisecondsToTime(openMs)
+	k.CloseTime = millisecondsToTime(closeMs)
+	k.Open = open
+	k.High = high
+	k.Low = low
+	k.Close = close_
+	k.Volume = volume
+	k.QuoteAssetVolume = quoteVolume
+	k.NumberOfTrades = numTrades
+	k.TakerBuyBaseAssetVolume = takerBuyBase
+	k.TakerBuyQuoteAssetVolume = takerBuyQuote
+	return nil
+}
+
+func millisecondsToTime(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
+
+// Klines fetches candlestick data for req. opts can carry extra query
+// parameters that have no dedicated field on KlinesRequest; they are
+// embedded after req, so a key set by both takes the opts value.
+func (c Client) Klines(req KlinesRequest, opts ...OptionalParameter) (*KlinesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s", c.base, klinesPath)
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	q := parsedURL.Query()
+	req.EmbedData(&q)
+	for _, opt := range opts {
+		opt.EmbedData(&q)
+	}
+	parsedURL.RawQuery = q.Encode()
+
+	resp, err := c.do(http.MethodGet, parsedURL.String(), klinesWeight)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var klines KlinesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&klines.Klines); err != nil {
+		return nil, err
+	}
+	return &klines, nil
+}
+
+// GetKlineRecords is a convenience wrapper around Klines for the common case
+// of fetching by symbol/period/limit plus any extra OptionalParameter, e.g.
+//
+//	c.GetKlineRecords("BTCUSDC", binance.Period1Hour, 100, binance.OptionalParameter{"startTime": start})
+func (c Client) GetKlineRecords(symbol string, period KlinePeriod, limit int, opts ...OptionalParameter) ([]Kline, error) {
+	resp, err := c.Klines(KlinesRequest{Symbol: symbol, Period: period, Limit: limit}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Klines, nil
+}
+
+// LatestCloseFn returns a closure shaped like tracker.GetDataFn
+// (func(ctx) ([][]string, error), [][]string being tracker.TrackedData's
+// underlying type) that fetches the single most recent candle for
+// symbol/period and reports its close price as one single-field record. Its
+// type is not tracker.GetDataFn itself, so a caller in the tracker module
+// wraps it in a func returning tracker.TrackedData before passing it to
+// tracker.SourceFromGetDataFn.
+func (c Client) LatestCloseFn(symbol string, period KlinePeriod) func(ctx context.Context) ([][]string, error) {
+	return func(ctx context.Context) ([][]string, error) {
+		resp, err := c.Klines(KlinesRequest{Symbol: symbol, Period: period, Limit: 1})
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Klines) == 0 {
+			return nil, fmt.Errorf("binance: no klines returned for %s %s", symbol, period)
+		}
+		last := resp.Klines[len(resp.Klines)-1]
+		return [][]string{{last.Close.String()}}, nil
+	}
+}
@@ -0,0 +1,74 @@
+package binance
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// usedWeightHeader reports the caller's total request weight consumed in
+	// the current minute window, refreshed by Binance on every response.
+	usedWeightHeader = "X-Mbx-Used-Weight-1m"
+
+	// defaultWeightBudget mirrors Binance's default per-IP limit of 1200
+	// request weight per minute.
+	defaultWeightBudget = 1200
+)
+
+// weightLimiter is a token-bucket limiter keyed on Binance's published
+// per-endpoint request weight rather than a plain request count: each call
+// is charged its endpoint's weight against one budget shared by all
+// endpoints, refilled in full every minute. It also reconciles itself
+// against the X-MBX-USED-WEIGHT-1M header
+// Binance echoes back, since the server's view of the window is authoritative.
+type weightLimiter struct {
+	mu       sync.Mutex
+	budget   int
+	used     int
+	resetsAt time.Time
+}
+
+// newWeightLimiter returns a limiter starting with a full defaultWeightBudget.
+func newWeightLimiter() *weightLimiter {
+	return &weightLimiter{budget: defaultWeightBudget, resetsAt: time.Now().Add(time.Minute)}
+}
+
+// wait blocks until weight is available, sleeping until the next minute
+// window if the budget would otherwise be exceeded. A nil receiver (a
+// Client built as a bare struct literal instead of via NewBinance) is a
+// no-op: no limiter means nothing to wait on.
+func (l *weightLimiter) wait(weight int) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	if time.Now().After(l.resetsAt) {
+		l.used = 0
+		l.resetsAt = time.Now().Add(time.Minute)
+	}
+	wait := time.Duration(0)
+	if l.used+weight > l.budget {
+		wait = time.Until(l.resetsAt)
+	}
+	l.used += weight
+	l.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// reconcile updates the limiter's used weight from the value Binance reports
+// in X-MBX-USED-WEIGHT-1M, which is more accurate than our own bookkeeping
+// once other processes share the same API key. A nil receiver is a no-op,
+// same as wait.
+func (l *weightLimiter) reconcile(usedWeight int) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if usedWeight > l.used {
+		l.used = usedWeight
+	}
+}
@@ -0,0 +1,44 @@
+package binance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightLimiter_Wait_DoesNotBlockWithinBudget(t *testing.T) {
+	l := newWeightLimiter()
+	done := make(chan struct{})
+	go func() {
+		l.wait(defaultWeightBudget)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("wait blocked despite staying within budget")
+	}
+	require.Equal(t, defaultWeightBudget, l.used)
+}
+
+func TestWeightLimiter_Wait_BlocksOnceBudgetExceeded(t *testing.T) {
+	l := newWeightLimiter()
+	l.resetsAt = time.Now().Add(50 * time.Millisecond)
+	l.wait(defaultWeightBudget)
+
+	start := time.Now()
+	l.wait(1)
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestWeightLimiter_Reconcile_OnlyRaisesUsed(t *testing.T) {
+	l := newWeightLimiter()
+	l.used = 100
+
+	l.reconcile(50)
+	require.Equal(t, 100, l.used)
+
+	l.reconcile(300)
+	require.Equal(t, 300, l.used)
+}
@@ -0,0 +1,50 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	userDataStreamPath = "api/v3/userDataStream"
+
+	// listenKeyWeight covers both the creation and keep-alive calls.
+	listenKeyWeight = 1
+)
+
+type listenKeyResponse struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// CreateUserDataStream asks Binance for a new listenKey, used to open the
+// user-data websocket stream. The key is valid for 60 minutes unless kept
+// alive with KeepAliveUserDataStream.
+func (c Client) CreateUserDataStream() (string, error) {
+	u := fmt.Sprintf("%s/%s", c.base, userDataStreamPath)
+
+	resp, err := c.do(http.MethodPost, u, listenKeyWeight)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var key listenKeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+		return "", err
+	}
+	return key.ListenKey, nil
+}
+
+// KeepAliveUserDataStream extends a listenKey's validity by another 60
+// minutes. Binance expects this to be called at least every 30 minutes.
+func (c Client) KeepAliveUserDataStream(listenKey string) error {
+	u := fmt.Sprintf("%s/%s?listenKey=%s", c.base, userDataStreamPath, listenKey)
+
+	resp, err := c.do(http.MethodPut, u, listenKeyWeight)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
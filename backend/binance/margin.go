@@ -0,0 +1,325 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	marginLoanPath            = "sapi/v1/margin/loan"
+	marginRepayPath           = "sapi/v1/margin/repay"
+	marginMaxBorrowablePath   = "sapi/v1/margin/maxBorrowable"
+	marginInterestHistoryPath = "sapi/v1/margin/interestHistory"
+
+	// weights are taken from the endpoint weight table published at
+	// https://github.com/binance/binance-spot-api-docs/blob/master/margin-api.md
+	marginLoanWeight            = 1
+	marginRepayWeight           = 1
+	marginMaxBorrowableWeight   = 5
+	marginInterestHistoryWeight = 1
+)
+
+// MarginSettings toggles a Client's margin requests between cross margin
+// (the default) and a specific isolated margin symbol.
+type MarginSettings struct {
+	IsMargin       bool
+	IsIsolated     bool
+	IsolatedSymbol string
+}
+
+// embed adds the isolated-margin query parameters shared by every margin
+// endpoint, per the "isIsolated"/"symbol" fields documented on each of them.
+func (m MarginSettings) embed(q *url.Values) {
+	if !m.IsIsolated {
+		return
+	}
+	q.Set("isIsolated", "TRUE")
+	q.Set("symbol", m.IsolatedSymbol)
+}
+
+// MarginBorrowRepay is implemented by MarginLoanRequest and MarginRepayRequest,
+// the two endpoints that move a margin loan balance.
+type MarginBorrowRepay interface {
+	RequestInterface
+	Validate() error
+}
+
+// MarginLoanRequest describes a margin borrow request.
+type MarginLoanRequest struct {
+	Asset     string
+	Amount    decimal.Decimal
+	Settings  MarginSettings
+	Timestamp time.Time
+}
+
+func (m MarginLoanRequest) Validate() error {
+	if m.Asset == "" {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "asset field is mandatory"}
+	}
+	if m.Amount.IsZero() {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "amount field is mandatory"}
+	}
+	return nil
+}
+
+func (m MarginLoanRequest) EmbedData(q *url.Values) {
+	q.Set("asset", m.Asset)
+	q.Set("amount", m.Amount.String())
+	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(m.Timestamp)))
+	m.Settings.embed(q)
+}
+
+// MarginRepayRequest describes a margin repay request.
+type MarginRepayRequest struct {
+	Asset     string
+	Amount    decimal.Decimal
+	Settings  MarginSettings
+	Timestamp time.Time
+}
+
+func (m MarginRepayRequest) Validate() error {
+	if m.Asset == "" {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "asset field is mandatory"}
+	}
+	if m.Amount.IsZero() {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "amount field is mandatory"}
+	}
+	return nil
+}
+
+func (m MarginRepayRequest) EmbedData(q *url.Values) {
+	q.Set("asset", m.Asset)
+	q.Set("amount", m.Amount.String())
+	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(m.Timestamp)))
+	m.Settings.embed(q)
+}
+
+// MarginTransactionResponse is returned by both Borrow and Repay; Binance
+// replies with the same shape, a single transaction id, for either.
+type MarginTransactionResponse struct {
+	TranID int64 `json:"tranId"`
+}
+
+// MaxBorrowableRequest asks how much of asset the account can still borrow.
+type MaxBorrowableRequest struct {
+	Asset     string
+	Settings  MarginSettings
+	Timestamp time.Time
+}
+
+func (m MaxBorrowableRequest) Validate() error {
+	if m.Asset == "" {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "asset field is mandatory"}
+	}
+	return nil
+}
+
+func (m MaxBorrowableRequest) EmbedData(q *url.Values) {
+	q.Set("asset", m.Asset)
+	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(m.Timestamp)))
+	m.Settings.embed(q)
+}
+
+// MaxBorrowableResponse is the account's remaining borrow capacity for an asset.
+type MaxBorrowableResponse struct {
+	Amount      decimal.Decimal `json:"amount"`
+	BorrowLimit decimal.Decimal `json:"borrowLimit"`
+}
+
+// MarginHistoryType names the three margin history kinds: loan, repay and
+// interest. Their endpoints share an identical request shape, differing only
+// in path and result item; each is queried through its own method
+// (QueryLoanHistory, QueryRepayHistory, QueryInterestHistory), none of which
+// takes a MarginHistoryType.
+type MarginHistoryType string
+
+const (
+	MarginHistoryLoan     MarginHistoryType = "loan"
+	MarginHistoryRepay    MarginHistoryType = "repay"
+	MarginHistoryInterest MarginHistoryType = "interest"
+)
+
+// MarginHistoryRequest describes a loan/repay/interest history query.
+type MarginHistoryRequest struct {
+	Asset     string // Optional; omitted fetches all assets.
+	Settings  MarginSettings
+	StartTime time.Time
+	EndTime   time.Time
+	Timestamp time.Time
+}
+
+func (m MarginHistoryRequest) Validate() error {
+	return nil
+}
+
+func (m MarginHistoryRequest) EmbedData(q *url.Values) {
+	if m.Asset != "" {
+		q.Set("asset", m.Asset)
+	}
+	if !m.StartTime.IsZero() {
+		q.Set("startTime", strconv.Itoa(timeToMilliseconds(m.StartTime)))
+	}
+	if !m.EndTime.IsZero() {
+		q.Set("endTime", strconv.Itoa(timeToMilliseconds(m.EndTime)))
+	}
+	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(m.Timestamp)))
+	m.Settings.embed(q)
+}
+
+// MarginLoanRecord is a single entry of QueryLoanHistory.
+type MarginLoanRecord struct {
+	TxID      int64           `json:"txId" bson:"txId"`
+	Asset     string          `json:"asset" bson:"asset"`
+	Principal decimal.Decimal `json:"principal" bson:"principal"`
+	Timestamp int64           `json:"timestamp" bson:"timestamp"`
+	Status    string          `json:"status" bson:"status"`
+}
+
+// MarginRepayRecord is a single entry of QueryRepayHistory.
+type MarginRepayRecord struct {
+	TxID      int64           `json:"txId" bson:"txId"`
+	Asset     string          `json:"asset" bson:"asset"`
+	Amount    decimal.Decimal `json:"amount" bson:"amount"`
+	Principal decimal.Decimal `json:"principal" bson:"principal"`
+	Interest  decimal.Decimal `json:"interest" bson:"interest"`
+	Timestamp int64           `json:"timestamp" bson:"timestamp"`
+	Status    string          `json:"status" bson:"status"`
+}
+
+// MarginInterest is a single entry of QueryInterestHistory.
+type MarginInterest struct {
+	TxID                int64           `json:"txId" bson:"txId"`
+	Asset               string          `json:"asset" bson:"asset"`
+	InterestAmount      decimal.Decimal `json:"interest" bson:"interest"`
+	InterestRate        decimal.Decimal `json:"interestRate" bson:"interestRate"`
+	InterestAccuredTime int64           `json:"interestAccuredTime" bson:"interestAccuredTime"`
+	IsolatedSymbol      string          `json:"isolatedSymbol" bson:"isolatedSymbol"`
+}
+
+// borrowRepay is the shared request/decode path for BorrowMarginAsset and
+// RepayMarginAsset: both POST a MarginBorrowRepay payload and get back the
+// same MarginTransactionResponse shape.
+func (c Client) borrowRepay(path string, req MarginBorrowRepay, weight int) (*MarginTransactionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s", c.base, path)
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(http.MethodPost, c.createURL(req, parsedURL), weight)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tran MarginTransactionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tran); err != nil {
+		return nil, err
+	}
+	return &tran, nil
+}
+
+// BorrowMarginAsset borrows req.Amount of req.Asset against the account's
+// margin collateral, cross or isolated depending on req.Settings.
+func (c Client) BorrowMarginAsset(req MarginLoanRequest) (*MarginTransactionResponse, error) {
+	return c.borrowRepay(marginLoanPath, req, marginLoanWeight)
+}
+
+// RepayMarginAsset repays req.Amount of req.Asset against an existing margin
+// loan, cross or isolated depending on req.Settings.
+func (c Client) RepayMarginAsset(req MarginRepayRequest) (*MarginTransactionResponse, error) {
+	return c.borrowRepay(marginRepayPath, req, marginRepayWeight)
+}
+
+// QueryMarginAssetMaxBorrowable returns how much more of req.Asset the
+// account can still borrow.
+func (c Client) QueryMarginAssetMaxBorrowable(req MaxBorrowableRequest) (*MaxBorrowableResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s", c.base, marginMaxBorrowablePath)
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(http.MethodGet, c.createURL(req, parsedURL), marginMaxBorrowableWeight)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out MaxBorrowableResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// QueryLoanHistory returns the account's margin borrow transactions matching
+// req. It's a GET against the same endpoint BorrowMarginAsset POSTs to.
+func (c Client) QueryLoanHistory(req MarginHistoryRequest) ([]MarginLoanRecord, error) {
+	var out struct {
+		Rows []MarginLoanRecord `json:"rows"`
+	}
+	if err := c.queryMarginHistory(marginLoanPath, req, marginLoanWeight, &out); err != nil {
+		return nil, err
+	}
+	return out.Rows, nil
+}
+
+// QueryRepayHistory returns the account's margin repay transactions matching
+// req. It's a GET against the same endpoint RepayMarginAsset POSTs to.
+func (c Client) QueryRepayHistory(req MarginHistoryRequest) ([]MarginRepayRecord, error) {
+	var out struct {
+		Rows []MarginRepayRecord `json:"rows"`
+	}
+	if err := c.queryMarginHistory(marginRepayPath, req, marginRepayWeight, &out); err != nil {
+		return nil, err
+	}
+	return out.Rows, nil
+}
+
+// QueryInterestHistory returns the account's accrued margin interest matching req.
+func (c Client) QueryInterestHistory(req MarginHistoryRequest) ([]MarginInterest, error) {
+	var out struct {
+		Rows []MarginInterest `json:"rows"`
+	}
+	if err := c.queryMarginHistory(marginInterestHistoryPath, req, marginInterestHistoryWeight, &out); err != nil {
+		return nil, err
+	}
+	return out.Rows, nil
+}
+
+// queryMarginHistory is the shared GET+decode body for the three margin
+// history endpoints, which only differ in path and row shape.
+func (c Client) queryMarginHistory(path string, req MarginHistoryRequest, weight int, out interface{}) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/%s", c.base, path)
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(http.MethodGet, c.createURL(req, parsedURL), weight)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,66 @@
+package binance
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// cursorCollectionSuffix is appended to a data collection's name to get the
+// collection its sync cursors are checkpointed in, keeping cursors next to
+// the data they describe without mixing documents of different shapes.
+const cursorCollectionSuffix = "_cursors"
+
+// MongoStore is a Store backed by MongoDB, reusing the same database the
+// Lambda entry point already writes account snapshots to.
+type MongoStore struct {
+	trades  *mongo.Collection
+	cursors *mongo.Collection
+}
+
+// NewMongoStore returns a MongoStore that writes trades into coll and
+// checkpoints cursors into a sibling "<coll>_cursors" collection.
+func NewMongoStore(db *mongo.Database, coll string) *MongoStore {
+	return &MongoStore{
+		trades:  db.Collection(coll),
+		cursors: db.Collection(coll + cursorCollectionSuffix),
+	}
+}
+
+func (s *MongoStore) SaveTrades(ctx context.Context, symbol string, trades []Trade) error {
+	if len(trades) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, len(trades))
+	for i, t := range trades {
+		docs[i] = t
+	}
+	_, err := s.trades.InsertMany(ctx, docs)
+	return err
+}
+
+func (s *MongoStore) SaveAccount(ctx context.Context, account AccountResponse) error {
+	_, err := s.trades.InsertOne(ctx, &account)
+	return err
+}
+
+func (s *MongoStore) LoadCursor(ctx context.Context, symbol string) (Cursor, error) {
+	var cursor Cursor
+	err := s.cursors.FindOne(ctx, bson.M{"symbol": symbol}).Decode(&cursor)
+	if err == mongo.ErrNoDocuments {
+		return Cursor{Symbol: symbol}, nil
+	}
+	return cursor, err
+}
+
+func (s *MongoStore) SaveCursor(ctx context.Context, cursor Cursor) error {
+	_, err := s.cursors.UpdateOne(
+		ctx,
+		bson.M{"symbol": cursor.Symbol},
+		bson.M{"$set": cursor},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
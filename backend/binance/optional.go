@@ -0,0 +1,28 @@
+package binance
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// OptionalParameter carries extra query parameters (startTime, endTime,
+// fromId, recvWindow, ...) a request wants to send without every possible
+// combination needing its own struct field. Any number of these can be
+// passed alongside a request's own EmbedData.
+type OptionalParameter map[string]interface{}
+
+// EmbedData writes every key/value pair into q. A time.Time value is sent
+// as Binance's millisecond-epoch timestamp rather than its default string
+// format, matching how every other time.Time field in this package is
+// embedded.
+func (o OptionalParameter) EmbedData(q *url.Values) {
+	for k, v := range o {
+		if t, ok := v.(time.Time); ok {
+			q.Set(k, strconv.Itoa(timeToMilliseconds(t)))
+			continue
+		}
+		q.Set(k, fmt.Sprintf("%v", v))
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 type OrderRequest struct {
@@ -41,18 +43,56 @@ func (o OrderRequest) EmbedData(q *url.Values) {
 
 }
 
+// AllOrdersRequest is the query for GET api/v3/allOrders, the account's full
+// order (and OCO order-list) history.
+type AllOrdersRequest struct {
+	Timestamp time.Time
+}
+
+func (a AllOrdersRequest) Validate() error {
+	if a.Timestamp.IsZero() {
+		return BinanceCliErr{Err: ErrInvalidData, Msg: "timestamp field is mandatory"}
+	}
+	return nil
+}
+
+func (a AllOrdersRequest) EmbedData(q *url.Values) {
+	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(a.Timestamp)))
+}
+
+type AllOrdersResponse struct {
+	Orders []Order
+}
+
+type Order struct {
+	Orderlistid       int       `json:"orderListId"`
+	Contingencytype   string    `json:"contingencyType"`
+	Liststatustype    string    `json:"listStatusType"`
+	Listorderstatus   string    `json:"listOrderStatus"`
+	Listclientorderid string    `json:"listClientOrderId"`
+	Transactiontime   int64     `json:"transactionTime"`
+	Symbol            string    `json:"symbol"`
+	Orders            OrderSpec `json:"orders"`
+}
+
+type OrderSpec struct {
+	Symbol        string `json:"symbol"`
+	Orderid       int    `json:"orderId"`
+	Clientorderid string `json:"clientOrderId"`
+}
+
 type OrderResponse struct {
-	Symbol              string `json:"symbol"`
-	Orderid             int    `json:"orderId"`
-	Orderlistid         int    `json:"orderListId"`
-	Clientorderid       string `json:"clientOrderId"`
-	Transacttime        int64  `json:"transactTime"`
-	Price               string `json:"price"`
-	Origqty             string `json:"origQty"`
-	Executedqty         string `json:"executedQty"`
-	Cummulativequoteqty string `json:"cummulativeQuoteQty"`
-	Status              string `json:"status"`
-	Timeinforce         string `json:"timeInForce"`
-	Type                string `json:"type"`
-	Side                string `json:"side"`
+	Symbol              string          `json:"symbol"`
+	Orderid             int             `json:"orderId"`
+	Orderlistid         int             `json:"orderListId"`
+	Clientorderid       string          `json:"clientOrderId"`
+	Transacttime        int64           `json:"transactTime"`
+	Price               decimal.Decimal `json:"price"`
+	Origqty             decimal.Decimal `json:"origQty"`
+	Executedqty         decimal.Decimal `json:"executedQty"`
+	Cummulativequoteqty decimal.Decimal `json:"cummulativeQuoteQty"`
+	Status              string          `json:"status"`
+	Timeinforce         string          `json:"timeInForce"`
+	Type                string          `json:"type"`
+	Side                string          `json:"side"`
 }
@@ -1,6 +1,19 @@
 package binance
 
-import "net/url"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	avgPricePath    = "api/v3/avgPrice"
+	tickerPricePath = "api/v3/ticker/price"
+
+	avgPriceWeight    = 1
+	tickerPriceWeight = 1
+)
 
 type symbolFn func() string
 
@@ -19,3 +32,57 @@ type CurrentAveragePriceResponse struct {
 	Mins  int    `json:"mins"`
 	Price string `json:"price"`
 }
+
+// CurrentAveragePrice returns the current average price for symbol.
+func (c Client) CurrentAveragePrice(symbol string) (*CurrentAveragePriceResponse, error) {
+	u := fmt.Sprintf("%s/%s", c.base, avgPricePath)
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	q := parsedURL.Query()
+	newSymbolFn(symbol).EmbedData(&q)
+	parsedURL.RawQuery = q.Encode()
+
+	resp, err := c.do(http.MethodGet, parsedURL.String(), avgPriceWeight)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out CurrentAveragePriceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SymbolTickerPriceResponse is the latest price for a single symbol.
+type SymbolTickerPriceResponse struct {
+	Symbol string `json:"symbol"`
+	Price  string `json:"price"`
+}
+
+// SymbolTickerPrice returns the latest price for symbol.
+func (c Client) SymbolTickerPrice(symbol string) (*SymbolTickerPriceResponse, error) {
+	u := fmt.Sprintf("%s/%s", c.base, tickerPricePath)
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	q := parsedURL.Query()
+	newSymbolFn(symbol).EmbedData(&q)
+	parsedURL.RawQuery = q.Encode()
+
+	resp, err := c.do(http.MethodGet, parsedURL.String(), tickerPriceWeight)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out SymbolTickerPriceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,45 @@
+package binance
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	maxRetries     = 5
+	baseRetryDelay = 200 * time.Millisecond
+	maxRetryDelay  = 10 * time.Second
+)
+
+// httpCodeBanned and httpCodeRateLimited are the statuses Binance uses to
+// signal a breached rate limit: 429 asks the caller to back off, 418 means
+// the IP has already been auto-banned for ignoring 429s.
+const (
+	httpCodeRateLimited = http.StatusTooManyRequests
+	httpCodeBanned      = 418
+)
+
+// shouldRetry reports whether status is worth retrying: 5xx (transient
+// server trouble) or the two rate-limit statuses above.
+func shouldRetry(status int) bool {
+	return status >= 500 || status == httpCodeRateLimited || status == httpCodeBanned
+}
+
+// retryDelay returns how long to wait after the 0-based attempt failed,
+// before the next one is sent, honoring the
+// Retry-After header when the server sent one and otherwise falling back to
+// an exponential backoff capped at maxRetryDelay.
+func retryDelay(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	delay := baseRetryDelay * time.Duration(math.Pow(2, float64(attempt)))
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
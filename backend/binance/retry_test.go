@@ -0,0 +1,35 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldRetry(t *testing.T) {
+	require.True(t, shouldRetry(http.StatusInternalServerError))
+	require.True(t, shouldRetry(http.StatusTooManyRequests))
+	require.True(t, shouldRetry(httpCodeBanned))
+	require.False(t, shouldRetry(http.StatusOK))
+	require.False(t, shouldRetry(http.StatusBadRequest))
+}
+
+func TestRetryDelay_HonorsRetryAfterHeader(t *testing.T) {
+	require.Equal(t, 5*time.Second, retryDelay(0, "5"))
+}
+
+func TestRetryDelay_IgnoresGarbageRetryAfter(t *testing.T) {
+	require.Equal(t, baseRetryDelay, retryDelay(0, "not-a-number"))
+}
+
+func TestRetryDelay_ExponentialBackoff(t *testing.T) {
+	require.Equal(t, baseRetryDelay, retryDelay(0, ""))
+	require.Equal(t, 2*baseRetryDelay, retryDelay(1, ""))
+	require.Equal(t, 4*baseRetryDelay, retryDelay(2, ""))
+}
+
+func TestRetryDelay_CapsAtMaxRetryDelay(t *testing.T) {
+	require.Equal(t, maxRetryDelay, retryDelay(10, ""))
+}
@@ -0,0 +1,37 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	serverTimePath = "api/v3/time"
+
+	serverTimeWeight = 1
+)
+
+// serverTimeResponse is the wire shape of api/v3/time.
+type serverTimeResponse struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+// GetServerTime returns Binance's current server time, useful for checking
+// clock drift against recvWindow-bound signed requests.
+func (c Client) GetServerTime() (time.Time, error) {
+	u := fmt.Sprintf("%s/%s", c.base, serverTimePath)
+
+	resp, err := c.do(http.MethodGet, u, serverTimeWeight)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer resp.Body.Close()
+
+	var out serverTimeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return time.Time{}, err
+	}
+	return millisecondsToTime(out.ServerTime), nil
+}
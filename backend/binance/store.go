@@ -0,0 +1,64 @@
+package binance
+
+import (
+	"context"
+	"time"
+)
+
+// maxPageLimit is the largest page size Binance will accept for paginated
+// endpoints such as myTrades/allOrders.
+const maxPageLimit = 1000
+
+// Cursor tracks how far a symbol has been synced so the next Sync call only
+// pulls the delta instead of re-downloading history.
+type Cursor struct {
+	Symbol    string    `bson:"symbol"`
+	FromID    int       `bson:"fromId"`  // next trade id to fetch: the highest id persisted so far plus one.
+	EndTime   time.Time `bson:"endTime"` // timestamp of the last synced record.
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// Store persists synced Binance data and the per-symbol Cursor needed to
+// resume incremental sync. This package implements it with MongoStore and
+// with InMemoryStore (an in-memory map for tests); it has no Postgres
+// implementation.
+type Store interface {
+	SaveTrades(ctx context.Context, symbol string, trades []Trade) error
+	SaveAccount(ctx context.Context, account AccountResponse) error
+
+	LoadCursor(ctx context.Context, symbol string) (Cursor, error)
+	SaveCursor(ctx context.Context, cursor Cursor) error
+}
+
+// InMemoryStore is a Store backed by process memory. It's mainly useful for
+// tests and local runs of the syncer without a database.
+type InMemoryStore struct {
+	trades  map[string][]Trade
+	cursors map[string]Cursor
+}
+
+// NewInMemoryStore returns an empty InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		trades:  make(map[string][]Trade),
+		cursors: make(map[string]Cursor),
+	}
+}
+
+func (s *InMemoryStore) SaveTrades(ctx context.Context, symbol string, trades []Trade) error {
+	s.trades[symbol] = append(s.trades[symbol], trades...)
+	return nil
+}
+
+func (s *InMemoryStore) SaveAccount(ctx context.Context, account AccountResponse) error {
+	return nil
+}
+
+func (s *InMemoryStore) LoadCursor(ctx context.Context, symbol string) (Cursor, error) {
+	return s.cursors[symbol], nil
+}
+
+func (s *InMemoryStore) SaveCursor(ctx context.Context, cursor Cursor) error {
+	s.cursors[cursor.Symbol] = cursor
+	return nil
+}
@@ -0,0 +1,94 @@
+// Package stream opens websocket connections to Binance's market-data and
+// user-data streams and surfaces events as typed Go values, so callers don't
+// have to poll REST endpoints on a time.Ticker to see fills and price moves
+// land in close to real time.
+package stream
+
+// TradeEvent is the payload of a `<symbol>@trade` stream.
+type TradeEvent struct {
+	EventType    string `json:"e"`
+	EventTime    int64  `json:"E"`
+	Symbol       string `json:"s"`
+	TradeID      int64  `json:"t"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	BuyerOrderID int64  `json:"b"`
+	SellOrderID  int64  `json:"a"`
+	TradeTime    int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+}
+
+// KlineEvent is the payload of a `<symbol>@kline_<interval>` stream.
+type KlineEvent struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+	Kline     struct {
+		StartTime   int64  `json:"t"`
+		CloseTime   int64  `json:"T"`
+		Symbol      string `json:"s"`
+		Interval    string `json:"i"`
+		Open        string `json:"o"`
+		Close       string `json:"c"`
+		High        string `json:"h"`
+		Low         string `json:"l"`
+		Volume      string `json:"v"`
+		TradeCount  int    `json:"n"`
+		IsFinal     bool   `json:"x"`
+		QuoteVolume string `json:"q"`
+	} `json:"k"`
+}
+
+// DepthEvent is the payload of a `<symbol>@depth` diff stream.
+type DepthEvent struct {
+	EventType     string     `json:"e"`
+	EventTime     int64      `json:"E"`
+	Symbol        string     `json:"s"`
+	FirstUpdateID int64      `json:"U"`
+	FinalUpdateID int64      `json:"u"`
+	Bids          [][]string `json:"b"`
+	Asks          [][]string `json:"a"`
+}
+
+// BookTickerEvent is the payload of a `<symbol>@bookTicker` stream.
+type BookTickerEvent struct {
+	UpdateID int64  `json:"u"`
+	Symbol   string `json:"s"`
+	BidPrice string `json:"b"`
+	BidQty   string `json:"B"`
+	AskPrice string `json:"a"`
+	AskQty   string `json:"A"`
+}
+
+// ExecutionReportEvent is a user-data event emitted whenever one of the
+// account's orders changes state (new, filled, canceled, ...).
+type ExecutionReportEvent struct {
+	EventType       string `json:"e"`
+	EventTime       int64  `json:"E"`
+	Symbol          string `json:"s"`
+	ClientOrderID   string `json:"c"`
+	Side            string `json:"S"`
+	OrderType       string `json:"o"`
+	Quantity        string `json:"q"`
+	Price           string `json:"p"`
+	ExecutionType   string `json:"x"`
+	OrderStatus     string `json:"X"`
+	OrderID         int64  `json:"i"`
+	LastFilledQty   string `json:"l"`
+	LastFilledPrice string `json:"L"`
+	CommissionAsset string `json:"N"`
+	TransactionTime int64  `json:"T"`
+}
+
+// OutboundAccountPositionEvent is a user-data event reporting the balances
+// that changed as a result of an event generating a balance update.
+type OutboundAccountPositionEvent struct {
+	EventType  string `json:"e"`
+	EventTime  int64  `json:"E"`
+	LastUpdate int64  `json:"u"`
+	Balances   []struct {
+		Asset  string `json:"a"`
+		Free   string `json:"f"`
+		Locked string `json:"l"`
+	} `json:"B"`
+}
@@ -0,0 +1,170 @@
+package stream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// defaultBaseURL is Binance's combined-stream websocket endpoint.
+	defaultBaseURL = "wss://stream.binance.com:9443"
+
+	// rotateAfter is how long a connection is kept before it is closed and
+	// redialed, matching the 24 hours after which Binance closes a connection
+	// on its own. It is checked only when a message arrives.
+	rotateAfter = 24 * time.Hour
+
+	reconnectBackoff    = time.Second
+	maxReconnectBackoff = time.Minute
+)
+
+// MarketStream subscribes to one or more raw Binance market-data streams
+// (trade, kline_*, depth, bookTicker) over a combined-stream websocket
+// connection and republishes decoded events on typed channels.
+type MarketStream struct {
+	baseURL string
+	streams []string
+
+	Trades      chan TradeEvent
+	Klines      chan KlineEvent
+	Depth       chan DepthEvent
+	BookTickers chan BookTickerEvent
+}
+
+// NewMarketStream returns a MarketStream subscribed to streams, e.g.
+// "btcusdt@trade" or "ethusdt@kline_1m".
+func NewMarketStream(streams ...string) *MarketStream {
+	return &MarketStream{
+		baseURL:     defaultBaseURL,
+		streams:     streams,
+		Trades:      make(chan TradeEvent, 128),
+		Klines:      make(chan KlineEvent, 128),
+		Depth:       make(chan DepthEvent, 128),
+		BookTickers: make(chan BookTickerEvent, 128),
+	}
+}
+
+// combinedEnvelope wraps every message on a combined-stream connection.
+type combinedEnvelope struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// Run dials the combined-stream endpoint and dispatches events onto the
+// MarketStream's channels until ctx is canceled, transparently reconnecting
+// with resubscription on error and proactively rotating the connection
+// every rotateAfter since Binance closes streams at that boundary.
+func (m *MarketStream) Run(ctx context.Context) {
+	backoff := reconnectBackoff
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := m.runOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("stream: market stream disconnected: %s, reconnecting in %s", err, backoff)
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		backoff *= 2
+		if backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+	}
+}
+
+func (m *MarketStream) runOnce(ctx context.Context) error {
+	url := fmt.Sprintf("%s/stream?streams=%s", m.baseURL, strings.Join(m.streams, "/"))
+
+	conn, err := websocket.Dial(url, "", "https://stream.binance.com")
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	deadline := time.Now().Add(rotateAfter)
+	for {
+		if time.Now().After(deadline) {
+			return nil
+		}
+
+		var raw []byte
+		if err := websocket.Message.Receive(conn, &raw); err != nil {
+			return err
+		}
+		raw = maybeGunzip(raw)
+
+		var env combinedEnvelope
+		if err := json.Unmarshal(raw, &env); err != nil {
+			log.Printf("stream: failed to decode envelope: %s", err)
+			continue
+		}
+		m.dispatch(env)
+	}
+}
+
+func (m *MarketStream) dispatch(env combinedEnvelope) {
+	switch {
+	case strings.HasSuffix(env.Stream, "@trade"):
+		var e TradeEvent
+		if json.Unmarshal(env.Data, &e) == nil {
+			m.Trades <- e
+		}
+	case strings.Contains(env.Stream, "@kline_"):
+		var e KlineEvent
+		if json.Unmarshal(env.Data, &e) == nil {
+			m.Klines <- e
+		}
+	case strings.Contains(env.Stream, "@depth"):
+		var e DepthEvent
+		if json.Unmarshal(env.Data, &e) == nil {
+			m.Depth <- e
+		}
+	case strings.HasSuffix(env.Stream, "@bookTicker"):
+		var e BookTickerEvent
+		if json.Unmarshal(env.Data, &e) == nil {
+			m.BookTickers <- e
+		}
+	}
+}
+
+// maybeGunzip transparently decompresses a message if Binance sent it
+// gzip-framed; most streams are plain JSON so this is a no-op in practice.
+func maybeGunzip(raw []byte) []byte {
+	if len(raw) < 2 || raw[0] != 0x1f || raw[1] != 0x8b {
+		return raw
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return raw
+	}
+	defer r.Close()
+	decompressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		return raw
+	}
+	return decompressed
+}
@@ -0,0 +1,148 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// listenKeyRefreshInterval is how often the listenKey is kept alive. Binance
+// requires this at least every 30 minutes; we refresh more eagerly so a
+// missed tick doesn't let the key expire mid-session.
+const listenKeyRefreshInterval = 20 * time.Minute
+
+// ListenKeyProvider obtains and refreshes the listenKey that authorizes a
+// user-data stream. *binance.Client satisfies this.
+type ListenKeyProvider interface {
+	CreateUserDataStream() (string, error)
+	KeepAliveUserDataStream(listenKey string) error
+}
+
+// UserDataStream streams the authenticated account's executionReport and
+// outboundAccountPosition events over its own listenKey-scoped connection.
+type UserDataStream struct {
+	baseURL  string
+	provider ListenKeyProvider
+
+	ExecutionReports         chan ExecutionReportEvent
+	OutboundAccountPositions chan OutboundAccountPositionEvent
+}
+
+// NewUserDataStream returns a UserDataStream authorized through provider.
+func NewUserDataStream(provider ListenKeyProvider) *UserDataStream {
+	return &UserDataStream{
+		baseURL:                  defaultBaseURL,
+		provider:                 provider,
+		ExecutionReports:         make(chan ExecutionReportEvent, 128),
+		OutboundAccountPositions: make(chan OutboundAccountPositionEvent, 128),
+	}
+}
+
+// Run obtains a listenKey, opens the user-data stream, keeps the key alive
+// in the background, and dispatches decoded events until ctx is canceled,
+// reconnecting (with a fresh listenKey) on any error.
+func (u *UserDataStream) Run(ctx context.Context) {
+	backoff := reconnectBackoff
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := u.runOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("stream: user-data stream disconnected: %s, reconnecting in %s", err, backoff)
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		backoff *= 2
+		if backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+	}
+}
+
+func (u *UserDataStream) runOnce(ctx context.Context) error {
+	listenKey, err := u.provider.CreateUserDataStream()
+	if err != nil {
+		return fmt.Errorf("create listen key: %w", err)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go u.keepAlive(streamCtx, listenKey)
+
+	url := fmt.Sprintf("%s/ws/%s", u.baseURL, listenKey)
+	conn, err := websocket.Dial(url, "", "https://stream.binance.com")
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	deadline := time.Now().Add(rotateAfter)
+	for {
+		if time.Now().After(deadline) {
+			return nil
+		}
+
+		var raw []byte
+		if err := websocket.Message.Receive(conn, &raw); err != nil {
+			return err
+		}
+		u.dispatch(maybeGunzip(raw))
+	}
+}
+
+func (u *UserDataStream) keepAlive(ctx context.Context, listenKey string) {
+	ticker := time.NewTicker(listenKeyRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := u.provider.KeepAliveUserDataStream(listenKey); err != nil {
+				log.Printf("stream: failed to keep listen key alive: %s", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (u *UserDataStream) dispatch(raw []byte) {
+	var typed struct {
+		EventType string `json:"e"`
+	}
+	if err := json.Unmarshal(raw, &typed); err != nil {
+		log.Printf("stream: failed to decode user-data event: %s", err)
+		return
+	}
+
+	switch typed.EventType {
+	case "executionReport":
+		var e ExecutionReportEvent
+		if json.Unmarshal(raw, &e) == nil {
+			u.ExecutionReports <- e
+		}
+	case "outboundAccountPosition":
+		var e OutboundAccountPositionEvent
+		if json.Unmarshal(raw, &e) == nil {
+			u.OutboundAccountPositions <- e
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// exchangeInfoResponse is the subset of api/v3/exchangeInfo this package cares about.
+type exchangeInfoResponse struct {
+	Symbols []struct {
+		Symbol string `json:"symbol"`
+	} `json:"symbols"`
+}
+
+// Symbols enumerates every symbol currently traded on the exchange, used by
+// Syncer to discover what it should be syncing without a hard-coded list.
+func (c Client) Symbols() ([]string, error) {
+	u := fmt.Sprintf("%s/%s", c.base, exchangeInfoPath)
+	r, err := c.h.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	var info exchangeInfoResponse
+	if err := json.NewDecoder(r.Body).Decode(&info); err != nil {
+		return nil, err
+	}
+
+	symbols := make([]string, 0, len(info.Symbols))
+	for _, s := range info.Symbols {
+		symbols = append(symbols, s.Symbol)
+	}
+	return symbols, nil
+}
+
+// Syncer drives incremental sync of Binance account/trade data against a
+// pluggable Store, so a scheduled invocation (e.g. the Lambda entry point)
+// only has to pull what changed since the last run.
+type Syncer struct {
+	cli   *Client
+	store Store
+}
+
+// NewSyncer returns a Syncer that persists data fetched from cli into store.
+func NewSyncer(cli *Client, store Store) *Syncer {
+	return &Syncer{cli: cli, store: store}
+}
+
+// SyncSymbol pulls every trade for symbol that happened after the last
+// persisted Cursor, paginating in batches of maxPageLimit via fromId, and
+// advances the Cursor once the batch is persisted.
+func (s *Syncer) SyncSymbol(ctx context.Context, symbol string) error {
+	cursor, err := s.store.LoadCursor(ctx, symbol)
+	if err != nil {
+		return fmt.Errorf("load cursor for %s: %w", symbol, err)
+	}
+	cursor.Symbol = symbol
+
+	for {
+		resp, err := s.cli.MyTrades(MyTradesRequest{
+			Symbol:    symbol,
+			FromID:    cursor.FromID,
+			Limit:     maxPageLimit,
+			Timestamp: time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("fetch trades for %s: %w", symbol, err)
+		}
+		if len(resp.Trades) == 0 {
+			return nil
+		}
+
+		if err := s.store.SaveTrades(ctx, symbol, resp.Trades); err != nil {
+			return fmt.Errorf("save trades for %s: %w", symbol, err)
+		}
+
+		last := resp.Trades[len(resp.Trades)-1]
+		cursor.FromID = last.ID + 1
+		cursor.EndTime = millisecondsToTime(last.Time)
+		cursor.UpdatedAt = time.Now()
+		if err := s.store.SaveCursor(ctx, cursor); err != nil {
+			return fmt.Errorf("save cursor for %s: %w", symbol, err)
+		}
+
+		if len(resp.Trades) < maxPageLimit {
+			return nil
+		}
+	}
+}
+
+// SyncAll enumerates every exchange symbol via Symbols and syncs each in
+// turn, stopping at the first error so a failed symbol doesn't silently
+// advance its cursor past missed data.
+func (s *Syncer) SyncAll(ctx context.Context) error {
+	symbols, err := s.cli.Symbols()
+	if err != nil {
+		return fmt.Errorf("enumerate symbols: %w", err)
+	}
+	for _, symbol := range symbols {
+		if err := s.SyncSymbol(ctx, symbol); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncAccount fetches the current account snapshot and hands it to the
+// Store, independent of the per-symbol trade cursors.
+func (s *Syncer) SyncAccount(ctx context.Context) error {
+	account, err := s.cli.Account(AccountRequest{Timestamp: time.Now()})
+	if err != nil {
+		return fmt.Errorf("fetch account: %w", err)
+	}
+	return s.store.SaveAccount(ctx, *account)
+}
@@ -0,0 +1,81 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tradesPage writes a MyTradesResponse.Trades-shaped JSON array of n trades,
+// starting at id startID, as a canned httptest response.
+func tradesPage(w http.ResponseWriter, startID, n int) {
+	fmt.Fprint(w, "[")
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprintf(w, `{"id": %d, "symbol": "BTCUSDC"}`, startID+i)
+	}
+	fmt.Fprint(w, "]")
+}
+
+func TestSyncer_SyncSymbol_SinglePage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tradesPage(w, 1, 3)
+	}))
+	defer srv.Close()
+
+	c := NewBinance(http.DefaultClient, srv.URL, "apikey", []byte("secret"))
+	store := NewInMemoryStore()
+	s := NewSyncer(c, store)
+
+	require.NoError(t, s.SyncSymbol(context.Background(), "BTCUSDC"))
+
+	cursor, err := store.LoadCursor(context.Background(), "BTCUSDC")
+	require.NoError(t, err)
+	require.Equal(t, 4, cursor.FromID)
+	require.Len(t, store.trades["BTCUSDC"], 3)
+}
+
+func TestSyncer_SyncSymbol_ResumesFromCursor(t *testing.T) {
+	var gotFromID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFromID = r.URL.Query().Get("fromId")
+		tradesPage(w, 101, 1)
+	}))
+	defer srv.Close()
+
+	c := NewBinance(http.DefaultClient, srv.URL, "apikey", []byte("secret"))
+	store := NewInMemoryStore()
+	require.NoError(t, store.SaveCursor(context.Background(), Cursor{Symbol: "BTCUSDC", FromID: 100}))
+	s := NewSyncer(c, store)
+
+	require.NoError(t, s.SyncSymbol(context.Background(), "BTCUSDC"))
+
+	require.Equal(t, "100", gotFromID)
+	cursor, err := store.LoadCursor(context.Background(), "BTCUSDC")
+	require.NoError(t, err)
+	require.Equal(t, 102, cursor.FromID)
+}
+
+func TestSyncer_SyncSymbol_NoTradesLeavesCursorUntouched(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "[]")
+	}))
+	defer srv.Close()
+
+	c := NewBinance(http.DefaultClient, srv.URL, "apikey", []byte("secret"))
+	store := NewInMemoryStore()
+	require.NoError(t, store.SaveCursor(context.Background(), Cursor{Symbol: "BTCUSDC", FromID: 50}))
+	s := NewSyncer(c, store)
+
+	require.NoError(t, s.SyncSymbol(context.Background(), "BTCUSDC"))
+
+	cursor, err := store.LoadCursor(context.Background(), "BTCUSDC")
+	require.NoError(t, err)
+	require.Equal(t, 50, cursor.FromID)
+}
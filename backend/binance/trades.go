@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 type MyTradesRequest struct {
@@ -33,6 +35,21 @@ func (m MyTradesRequest) Validate() error {
 
 func (m MyTradesRequest) EmbedData(q *url.Values) {
 	q.Set("symbol", m.Symbol)
+	if m.FromID != 0 {
+		q.Set("fromId", strconv.Itoa(m.FromID))
+	}
+	if m.Limit != 0 {
+		q.Set("limit", strconv.Itoa(m.Limit))
+	}
+	if !m.StartTime.IsZero() {
+		q.Set("startTime", strconv.Itoa(timeToMilliseconds(m.StartTime)))
+	}
+	if !m.EndTime.IsZero() {
+		q.Set("endTime", strconv.Itoa(timeToMilliseconds(m.EndTime)))
+	}
+	if m.RecvWindow != 0 {
+		q.Set("recvWindow", strconv.Itoa(m.RecvWindow))
+	}
 	q.Set("timestamp", strconv.Itoa(timeToMilliseconds(m.Timestamp)))
 }
 
@@ -41,19 +58,19 @@ type MyTradesResponse struct {
 }
 
 type Trade struct {
-	Symbol          string `json:"symbol"`
-	ID              int    `json:"id"`
-	Orderid         int    `json:"orderId"`
-	Orderlistid     int    `json:"orderListId"`
-	Price           string `json:"price"`
-	Qty             string `json:"qty"`
-	Quoteqty        string `json:"quoteQty"`
-	Commission      string `json:"commission"`
-	Commissionasset string `json:"commissionAsset"`
-	Time            int64  `json:"time"`
-	Isbuyer         bool   `json:"isBuyer"`
-	Ismaker         bool   `json:"isMaker"`
-	Isbestmatch     bool   `json:"isBestMatch"`
+	Symbol          string          `json:"symbol"`
+	ID              int             `json:"id"`
+	Orderid         int             `json:"orderId"`
+	Orderlistid     int             `json:"orderListId"`
+	Price           decimal.Decimal `json:"price"`
+	Qty             decimal.Decimal `json:"qty"`
+	Quoteqty        decimal.Decimal `json:"quoteQty"`
+	Commission      decimal.Decimal `json:"commission"`
+	Commissionasset string          `json:"commissionAsset"`
+	Time            int64           `json:"time"`
+	Isbuyer         bool            `json:"isBuyer"`
+	Ismaker         bool            `json:"isMaker"`
+	Isbestmatch     bool            `json:"isBestMatch"`
 }
 
 func (t Trade) GetTime() time.Time {
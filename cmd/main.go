@@ -37,7 +37,7 @@ func main() {
 	log := log.Default()
 	tr := tracker.NewTracker(srv, log)
 	tr.AddTrackingFn("A", time.Second*15, func(ctx context.Context) (tracker.TrackedData, error) {
-		return []string{"1", "2"}, nil
+		return tracker.TrackedData{{"1", "2"}}, nil
 	}, tracker.WithTimestamp(true))
 	tr.Start(context.Background())
 	time.Sleep(time.Minute)
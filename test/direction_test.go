@@ -0,0 +1,82 @@
+package integration_test
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"data-tracker/api"
+	"data-tracker/tracker"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTracking_RowDirection tests that a row Direction writes each record as
+// a new column, fields spreading downward, the transpose of the column
+// direction's row-per-record layout.
+func TestTracking_RowDirection(t *testing.T) {
+	wrapper, err := api.NewAPIWrapperWithInit(context.Background())
+	require.NoError(t, err)
+
+	tr := tracker.NewTracker(wrapper.Service(), log.Default())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	record := []string{randomData(), randomData()}
+	done := make(chan struct{}) // will be closed after data is written.
+
+	tt := tracker.NewTrackingTask(
+		spreadsheetID,
+		tracker.Direction("1"),
+		time.Second,
+		tracker.SourceFromGetDataFn(func(ctx context.Context) (tracker.TrackedData, error) {
+			return tracker.TrackedData{record}, nil
+		}),
+		tracker.WithCallback(func(err error) { close(done) }),
+	)
+
+	tr.AddTrackingFn(tt)
+	tr.Start(ctx)
+	<-done
+
+	column, err := wrapper.GetColumn(spreadsheetID, "A1:A2")
+	require.NoError(t, err)
+	require.Equal(t, stringSliceToInterfaceSlice(record), column)
+}
+
+// TestTracking_TimestampLeading tests that WithTimestamp(false) places the
+// timestamp before the data rather than after it.
+func TestTracking_TimestampLeading(t *testing.T) {
+	wrapper, err := api.NewAPIWrapperWithInit(context.Background())
+	require.NoError(t, err)
+
+	tr := tracker.NewTracker(wrapper.Service(), log.Default())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	value := randomData()
+	done := make(chan struct{}) // will be closed after data is written.
+
+	tt := tracker.NewTrackingTask(
+		spreadsheetID,
+		tracker.Direction("B"),
+		time.Second,
+		tracker.SourceFromGetDataFn(func(ctx context.Context) (tracker.TrackedData, error) {
+			return tracker.TrackedData{{value}}, nil
+		}),
+		tracker.WithTimestamp(false),
+		tracker.WithCallback(func(err error) { close(done) }),
+	)
+
+	tr.AddTrackingFn(tt)
+	tr.Start(ctx)
+	<-done
+
+	row, err := wrapper.GetRow(spreadsheetID, "A1:B1")
+	require.NoError(t, err)
+	require.Len(t, row, 2)
+	require.Equal(t, value, row[1])
+}
@@ -44,7 +44,7 @@ func TestTracking(t *testing.T) {
 		time.Second,
 		func(ctx context.Context) (tracker.TrackedData, error) {
 			cancel()
-			return tracker.TrackedData{"elo"}, nil
+			return tracker.TrackedData{{"elo"}}, nil
 		},
 	)
 	tr.Start(ctx)
@@ -29,9 +29,9 @@ func TestTracking(t *testing.T) {
 		spreadsheetID,
 		tracker.Direction("A"),
 		time.Second,
-		func(ctx context.Context) (tracker.TrackedData, error) {
-			return tracker.TrackedData(data), nil
-		},
+		tracker.SourceFromGetDataFn(func(ctx context.Context) (tracker.TrackedData, error) {
+			return tracker.TrackedData{{data[0]}, {data[1]}, {data[2]}}, nil
+		}),
 		tracker.WithCallback(func(err error) { close(done) }),
 	)
 
@@ -0,0 +1,70 @@
+package tracker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// BatchWriter coalesces Sheets writes that target the same spreadsheet
+// within a flush window into a single Spreadsheets.Values.BatchUpdate call,
+// so many short-interval trackers sharing a spreadsheet don't each burn a
+// separate Values.Update quota unit per tick.
+type BatchWriter struct {
+	srv    *sheets.Service
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[string][]*sheets.ValueRange
+	timers  map[string]*time.Timer
+}
+
+// NewBatchWriter returns a BatchWriter that flushes each spreadsheet's
+// pending writes window after the first one is enqueued.
+func NewBatchWriter(srv *sheets.Service, window time.Duration) *BatchWriter {
+	return &BatchWriter{
+		srv:     srv,
+		window:  window,
+		pending: make(map[string][]*sheets.ValueRange),
+		timers:  make(map[string]*time.Timer),
+	}
+}
+
+// Enqueue adds vr to spreadsheetID's pending batch, starting the flush timer
+// if this is the first write since the last flush.
+func (b *BatchWriter) Enqueue(spreadsheetID string, vr *sheets.ValueRange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending[spreadsheetID] = append(b.pending[spreadsheetID], vr)
+	if _, running := b.timers[spreadsheetID]; !running {
+		b.timers[spreadsheetID] = time.AfterFunc(b.window, func() {
+			b.flush(spreadsheetID)
+		})
+	}
+}
+
+// flush sends every pending ValueRange for spreadsheetID in one
+// BatchUpdate call and clears the pending state for it.
+func (b *BatchWriter) flush(spreadsheetID string) {
+	b.mu.Lock()
+	data := b.pending[spreadsheetID]
+	delete(b.pending, spreadsheetID)
+	delete(b.timers, spreadsheetID)
+	b.mu.Unlock()
+
+	if len(data) == 0 {
+		return
+	}
+
+	_, err := b.srv.Spreadsheets.Values.BatchUpdate(spreadsheetID, &sheets.BatchUpdateValuesRequest{
+		ValueInputOption: "RAW",
+		Data:             data,
+	}).Context(context.Background()).Do()
+	if err != nil {
+		log.Printf("tracker: batch update for %s failed: %s", spreadsheetID, err)
+	}
+}
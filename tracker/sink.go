@@ -0,0 +1,309 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/api/sheets/v4"
+)
+
+// Sink writes a TrackingTask's freshly fetched TrackedData out to a
+// destination. Tracker ships with Sheets, Mongo, CSV, and Prometheus
+// implementations, and callers can add their own.
+type Sink interface {
+	Write(ctx context.Context, task TrackingTask, data TrackedData) error
+}
+
+// SheetsSink writes data into the spreadsheet/sheet/direction carried on the
+// TrackingTask itself. This is the original, and still default, Sink.
+type SheetsSink struct {
+	srv   *sheets.Service
+	batch *BatchWriter // optional; when set, writes are coalesced instead of sent immediately.
+}
+
+// NewSheetsSink returns a SheetsSink backed by srv.
+func NewSheetsSink(srv *sheets.Service) *SheetsSink {
+	return &SheetsSink{srv: srv}
+}
+
+// NewBatchedSheetsSink returns a SheetsSink whose writes are coalesced by
+// batch instead of issuing one Values.Update per tick.
+func NewBatchedSheetsSink(srv *sheets.Service, batch *BatchWriter) *SheetsSink {
+	return &SheetsSink{srv: srv, batch: batch}
+}
+
+// Write appends data to task's configured direction: a letter ("A", "B", ...)
+// writes a new entry down that column, a digit ("1", "2", ...) writes a new
+// entry across that row.
+func (s *SheetsSink) Write(ctx context.Context, task TrackingTask, data TrackedData) error {
+	if isRowDirection(task.direction) {
+		return s.writeRow(ctx, task, data)
+	}
+	return s.writeColumn(ctx, task, data)
+}
+
+// writeColumn appends each record in data as a new row below whatever is
+// already in task.direction's column, the record's fields spreading
+// rightward across that row.
+func (s *SheetsSink) writeColumn(ctx context.Context, task TrackingTask, data TrackedData) error {
+	range_ := AddSheetToRange(task.sheet, fmt.Sprintf("%s:%s", task.direction, task.direction))
+	resp, err := s.srv.Spreadsheets.Values.Get(task.spreadsheetID, range_).Do()
+	if err != nil {
+		return err
+	}
+
+	elementLen := len(resp.Values)
+	t1 := time.Now().String()
+
+	var vr sheets.ValueRange
+	for _, record := range data {
+		vr.Values = append(vr.Values, recordWithTimestamp(record, task, t1))
+	}
+
+	// A leading timestamp is spliced in as the first element of each row by
+	// recordWithTimestamp, so the write has to anchor one column left of
+	// task.direction or the timestamp displaces the tracked value into it.
+	writeCol := task.direction
+	if task.withTimestamp && !task.timestampAfter {
+		writeCol = shiftColumnLeft(task.direction)
+	}
+
+	vr.Range = AddSheetToRange(task.sheet, fmt.Sprintf("%s%d", writeCol, elementLen+1))
+	return s.update(ctx, task.spreadsheetID, &vr)
+}
+
+// writeRow appends each record in data as a new column to the right of
+// whatever is already in task.direction's row, the record's fields
+// spreading downward across that column. This is writeColumn transposed.
+func (s *SheetsSink) writeRow(ctx context.Context, task TrackingTask, data TrackedData) error {
+	range_ := AddSheetToRange(task.sheet, fmt.Sprintf("%s:%s", task.direction, task.direction))
+	resp, err := s.srv.Spreadsheets.Values.Get(task.spreadsheetID, range_).Do()
+	if err != nil {
+		return err
+	}
+
+	var existing []interface{}
+	if len(resp.Values) > 0 {
+		existing = resp.Values[0]
+	}
+	nextCol := len(existing) + 1
+
+	t1 := time.Now().String()
+	columns := make([][]interface{}, len(data))
+	maxLen := 0
+	for i, record := range data {
+		columns[i] = recordWithTimestamp(record, task, t1)
+		if len(columns[i]) > maxLen {
+			maxLen = len(columns[i])
+		}
+	}
+
+	var vr sheets.ValueRange
+	for row := 0; row < maxLen; row++ {
+		rowValues := make([]interface{}, len(columns))
+		for i, col := range columns {
+			if row < len(col) {
+				rowValues[i] = col[row]
+			} else {
+				rowValues[i] = ""
+			}
+		}
+		vr.Values = append(vr.Values, rowValues)
+	}
+
+	vr.Range = AddSheetToRange(task.sheet, fmt.Sprintf("%s%s", columnLetter(nextCol), task.direction))
+	return s.update(ctx, task.spreadsheetID, &vr)
+}
+
+// recordWithTimestamp converts record's fields to interface{} cell values,
+// splicing in ts at the leading or trailing position per
+// task.withTimestamp/task.timestampAfter.
+func recordWithTimestamp(record []string, task TrackingTask, ts string) []interface{} {
+	values := make([]interface{}, 0, len(record)+1)
+	if task.withTimestamp && !task.timestampAfter {
+		values = append(values, ts)
+	}
+	for _, field := range record {
+		values = append(values, field)
+	}
+	if task.withTimestamp && task.timestampAfter {
+		values = append(values, ts)
+	}
+	return values
+}
+
+// update sends vr to the spreadsheet, either immediately or via the sink's
+// BatchWriter if one is configured.
+func (s *SheetsSink) update(ctx context.Context, spreadsheetID string, vr *sheets.ValueRange) error {
+	if s.batch != nil {
+		s.batch.Enqueue(spreadsheetID, vr)
+		return nil
+	}
+
+	_, err := s.srv.Spreadsheets.Values.
+		Update(spreadsheetID, vr.Range, vr).
+		ValueInputOption("RAW").
+		Context(ctx).
+		Do()
+	return err
+}
+
+// isRowDirection reports whether d addresses a row ("1", "2", ...) rather
+// than a column ("A", "B", ...), per the doc comment on Direction.
+func isRowDirection(d Direction) bool {
+	return len(d) > 0 && d[0] >= '0' && d[0] <= '9'
+}
+
+// columnLetter converts a 1-indexed column number to its A1-notation
+// letter(s), e.g. 1 -> "A", 27 -> "AA".
+func columnLetter(col int) string {
+	var letters []byte
+	for col > 0 {
+		col--
+		letters = append([]byte{byte('A' + col%26)}, letters...)
+		col /= 26
+	}
+	return string(letters)
+}
+
+// columnNumber converts an A1-notation column letter, e.g. "A" or "AA", to
+// its 1-indexed column number. It's the inverse of columnLetter.
+func columnNumber(letters Direction) int {
+	col := 0
+	for _, c := range letters {
+		col = col*26 + int(c-'A') + 1
+	}
+	return col
+}
+
+// shiftColumnLeft returns the column immediately to the left of d, used to
+// give a leading timestamp its own column without displacing d's data.
+func shiftColumnLeft(d Direction) Direction {
+	return Direction(columnLetter(columnNumber(d) - 1))
+}
+
+// MongoSink persists each tick's data as its own document, reusing the same
+// collection wiring pattern the Lambda entry point uses for Binance account
+// snapshots.
+type MongoSink struct {
+	coll *mongo.Collection
+}
+
+// NewMongoSink returns a MongoSink writing into coll.
+func NewMongoSink(coll *mongo.Collection) *MongoSink {
+	return &MongoSink{coll: coll}
+}
+
+// trackedDataDoc is the document shape MongoSink writes; it's kept separate
+// from TrackedData so the sheet/column bookkeeping never leaks into storage.
+type trackedDataDoc struct {
+	Data      TrackedData `bson:"data"`
+	Timestamp time.Time   `bson:"timestamp"`
+}
+
+func (m *MongoSink) Write(ctx context.Context, task TrackingTask, data TrackedData) error {
+	_, err := m.coll.InsertOne(ctx, trackedDataDoc{Data: data, Timestamp: time.Now()})
+	return err
+}
+
+// CSVSink appends each record of a tick's data as a row to a CSV file under dir, rolling
+// over to a new file once the current one reaches maxBytes so a long-running
+// tracker doesn't grow a single unbounded file.
+type CSVSink struct {
+	dir      string
+	prefix   string
+	maxBytes int64
+
+	current   *os.File
+	currentSz int64
+}
+
+// NewCSVSink returns a CSVSink writing "<prefix>-<n>.csv" files under dir,
+// rolling over once a file would exceed maxBytes.
+func NewCSVSink(dir, prefix string, maxBytes int64) *CSVSink {
+	return &CSVSink{dir: dir, prefix: prefix, maxBytes: maxBytes}
+}
+
+func (c *CSVSink) Write(ctx context.Context, task TrackingTask, data TrackedData) error {
+	ts := time.Now().Format(time.RFC3339)
+	for _, record := range data {
+		line := strings.Join(record, ",")
+		if task.withTimestamp {
+			if task.timestampAfter {
+				line = fmt.Sprintf("%s,%s", line, ts)
+			} else {
+				line = fmt.Sprintf("%s,%s", ts, line)
+			}
+		}
+		line += "\n"
+
+		if c.current == nil || c.currentSz+int64(len(line)) > c.maxBytes {
+			if err := c.rollover(); err != nil {
+				return err
+			}
+		}
+
+		n, err := c.current.WriteString(line)
+		c.currentSz += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CSVSink) rollover() error {
+	if c.current != nil {
+		if err := c.current.Close(); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(c.dir, c.prefix+"-"+strconv.FormatInt(time.Now().UnixNano(), 10)+".csv")
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("roll over csv sink: %w", err)
+	}
+	c.current = f
+	c.currentSz = 0
+	return nil
+}
+
+// PrometheusSink exports each numeric data point as a gauge labeled by the
+// task's direction, so a tracker can be scraped instead of (or alongside)
+// written to a sheet.
+type PrometheusSink struct {
+	gauge *prometheus.GaugeVec
+}
+
+// NewPrometheusSink registers and returns a gauge vector labeled "direction",
+// "record" (a record's position within TrackedData), and "field" (a field's
+// position within its record).
+func NewPrometheusSink(name, help string) *PrometheusSink {
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: name,
+		Help: help,
+	}, []string{"direction", "record", "field"})
+	prometheus.MustRegister(gauge)
+	return &PrometheusSink{gauge: gauge}
+}
+
+func (p *PrometheusSink) Write(ctx context.Context, task TrackingTask, data TrackedData) error {
+	for ri, record := range data {
+		for fi, field := range record {
+			v, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				continue // non-numeric fields aren't exportable as a gauge.
+			}
+			p.gauge.WithLabelValues(string(task.direction), strconv.Itoa(ri), strconv.Itoa(fi)).Set(v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+)
+
+// Record is a single tracked entity's ordered fields, the unit TrackedData
+// is built out of.
+type Record = []string
+
+// Source produces the records a TrackingTask writes each tick. It replaces
+// a hand-written GetDataFn with something that can also describe its own
+// column layout, so the task's destination sheet can be given a header row
+// without the caller spelling it out twice.
+type Source interface {
+	// Name identifies the source, e.g. for logging.
+	Name() string
+	// Fetch returns this tick's records.
+	Fetch(ctx context.Context) ([]Record, error)
+	// Schema names each field Fetch's records carry, in order. It's written
+	// as a header row the first time a task backed by this Source writes.
+	Schema() []string
+}
+
+// sourceFn is a Source built from a plain fetch function, for callers that
+// don't need their own Source type.
+type sourceFn struct {
+	name   string
+	schema []string
+	fetch  func(ctx context.Context) ([]Record, error)
+}
+
+func (s sourceFn) Name() string                                { return s.name }
+func (s sourceFn) Schema() []string                            { return s.schema }
+func (s sourceFn) Fetch(ctx context.Context) ([]Record, error) { return s.fetch(ctx) }
+
+// NewFuncSource builds a Source from a plain fetch function.
+func NewFuncSource(name string, schema []string, fetch func(ctx context.Context) ([]Record, error)) Source {
+	return sourceFn{name: name, schema: schema, fetch: fetch}
+}
+
+// SourceFromGetDataFn adapts fn, a plain GetDataFn, into a Source with no
+// schema, so it can flow through the same Tracker pipeline as any other
+// Source. This is the compatibility shim that keeps GetDataFn-based callers
+// of NewTrackingTask working unchanged.
+func SourceFromGetDataFn(fn GetDataFn) Source {
+	return sourceFn{
+		name:  "getDataFn",
+		fetch: func(ctx context.Context) ([]Record, error) { return fn(ctx) },
+	}
+}
+
+// NewBinanceBalanceSource wraps fetch (typically a binance.Client's Account
+// call, reshaped into one record per non-zero balance) into a Source. The
+// fetch is injected rather than imported directly since the tracker module
+// doesn't depend on the backend module.
+func NewBinanceBalanceSource(fetch func(ctx context.Context) ([]Record, error)) Source {
+	return NewFuncSource("binance_balance", []string{"asset", "free", "locked"}, fetch)
+}
+
+// NewBinanceKlineSource wraps fetch (typically a binance.Client.Klines call,
+// reshaped into one record per candle) into a Source.
+func NewBinanceKlineSource(fetch func(ctx context.Context) ([]Record, error)) Source {
+	return NewFuncSource("binance_kline", []string{"openTime", "open", "high", "low", "close", "volume"}, fetch)
+}
+
+// NewBinanceTickerSource wraps fetch (typically a binance.Client symbol
+// ticker call, reshaped into one record per symbol) into a Source.
+func NewBinanceTickerSource(fetch func(ctx context.Context) ([]Record, error)) Source {
+	return NewFuncSource("binance_ticker", []string{"symbol", "price"}, fetch)
+}
+
+// NewBinanceMarginInterestSource wraps fetch (typically a
+// binance.Client.QueryInterestHistory call, reshaped into one record per
+// interest entry) into a Source.
+func NewBinanceMarginInterestSource(fetch func(ctx context.Context) ([]Record, error)) Source {
+	return NewFuncSource("binance_margin_interest", []string{"asset", "interest", "interestRate", "timestamp"}, fetch)
+}
+
+// MultiSource fans several single-record Sources out into one combined
+// record per tick, concatenating each Source's fields left to right in the
+// order given to NewMultiSource.
+type MultiSource struct {
+	name    string
+	sources []Source
+}
+
+// NewMultiSource returns a Source that, each tick, fetches every one of
+// sources and concatenates their fields into a single row. Each wrapped
+// Source must return exactly one record per Fetch.
+func NewMultiSource(name string, sources ...Source) *MultiSource {
+	return &MultiSource{name: name, sources: sources}
+}
+
+func (m *MultiSource) Name() string { return m.name }
+
+func (m *MultiSource) Schema() []string {
+	var schema []string
+	for _, s := range m.sources {
+		schema = append(schema, s.Schema()...)
+	}
+	return schema
+}
+
+func (m *MultiSource) Fetch(ctx context.Context) ([]Record, error) {
+	var row Record
+	for _, s := range m.sources {
+		records, err := s.Fetch(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("multiSource: fetch %s: %w", s.Name(), err)
+		}
+		if len(records) != 1 {
+			return nil, fmt.Errorf("multiSource: %s returned %d records, want 1", s.Name(), len(records))
+		}
+		row = append(row, records[0]...)
+	}
+	return []Record{row}, nil
+}
@@ -0,0 +1,141 @@
+package tracker
+
+import (
+	"context"
+	"time"
+)
+
+// StreamingTask appends a Sheets row for events arriving on its events
+// channel, instead of polling on a fixed interval like TrackingTask.
+// Consecutive events are debounced: at most one write is issued per
+// minInterval, coalescing everything received since the last flush into a
+// single append. The events channel is
+// expected to be fed by an adapter translating a streaming client's typed
+// channel (e.g. binance/stream.MarketStream.Trades) into Records.
+type StreamingTask struct {
+	spreadsheetID  string
+	sheet          string
+	direction      Direction
+	events         <-chan Record
+	minInterval    time.Duration
+	withTimestamp  bool
+	timestampAfter bool
+	callbacks      []Callback
+	sink           Sink
+}
+
+// streamOption is a function that sets StreamingTask fields.
+type streamOption func(*StreamingTask)
+
+// WithStreamSheet sets sheet.
+func WithStreamSheet(sheet string) streamOption {
+	return func(st *StreamingTask) {
+		st.sheet = sheet
+	}
+}
+
+// WithStreamTimestamp sets withTimestamp, same semantics as WithTimestamp.
+func WithStreamTimestamp(after bool) streamOption {
+	return func(st *StreamingTask) {
+		st.withTimestamp = true
+		st.timestampAfter = after
+	}
+}
+
+// WithStreamCallback adds one callback to StreamingTask.
+func WithStreamCallback(c Callback) streamOption {
+	return func(st *StreamingTask) {
+		st.callbacks = append(st.callbacks, c)
+	}
+}
+
+// WithStreamSink sets the Sink events are written to, instead of the
+// Tracker's default Google Sheets service.
+func WithStreamSink(s Sink) streamOption {
+	return func(st *StreamingTask) {
+		st.sink = s
+	}
+}
+
+// NewStreamingTask returns a new StreamingTask that appends a row to
+// spreadsheetID/direction for every receive on events, at most once per
+// minInterval.
+func NewStreamingTask(spreadsheetID string, direction Direction, events <-chan Record, minInterval time.Duration, opts ...streamOption) StreamingTask {
+	st := &StreamingTask{
+		spreadsheetID: spreadsheetID,
+		direction:     direction,
+		events:        events,
+		minInterval:   minInterval,
+	}
+	for _, opt := range opts {
+		opt(st)
+	}
+	return *st
+}
+
+// asTrackingTask projects the fields a Sink needs to know where/how to
+// write, so StreamingTask can reuse the same Sink implementations as
+// TrackingTask without the Sink interface needing to know about streaming
+// at all.
+func (st StreamingTask) asTrackingTask() TrackingTask {
+	return TrackingTask{
+		spreadsheetID:  st.spreadsheetID,
+		sheet:          st.sheet,
+		direction:      st.direction,
+		withTimestamp:  st.withTimestamp,
+		timestampAfter: st.timestampAfter,
+	}
+}
+
+// AddStreamingTask adds a StreamingTask to the set Start supervises.
+func (t *Tracker) AddStreamingTask(st StreamingTask) {
+	t.streams = append(t.streams, st)
+}
+
+// runStreamingTask drains st.events until ctx is done, coalescing whatever
+// arrives between ticks of st.minInterval into a single debounced Sink
+// write, then runs st's callbacks the same way a TrackingTask's do.
+func (t *Tracker) runStreamingTask(ctx context.Context, st StreamingTask) {
+	runCallbacks := func(err error) {
+		for _, cb := range st.callbacks {
+			cb(err)
+		}
+	}
+
+	sink := st.sink
+	if sink == nil {
+		sink = NewSheetsSink(t.srv)
+	}
+	task := st.asTrackingTask()
+
+	var pending TrackedData
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		data := pending
+		pending = nil
+
+		var err error
+		defer func() { runCallbacks(err) }()
+		err = sink.Write(ctx, task, data)
+	}
+
+	ticker := time.NewTicker(st.minInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case e, ok := <-st.events:
+			if !ok {
+				flush()
+				return
+			}
+			pending = append(pending, e)
+		case <-ticker.C:
+			flush()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
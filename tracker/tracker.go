@@ -9,8 +9,11 @@ import (
 	"google.golang.org/api/sheets/v4"
 )
 
-// TrackedData is a type wrap for data that is being tracked. It'll be written as string anyway.
-type TrackedData []string
+// TrackedData is the set of records a single tick produced. Each record is
+// an ordered list of fields written as one unit: in the column direction a
+// record becomes a new row (fields spread across columns), in the row
+// direction it becomes a new column (fields spread across rows).
+type TrackedData [][]string
 
 // GetDataFn is w function that returns data that will be written to google sheet cell.
 type GetDataFn func(ctx context.Context) (TrackedData, error)
@@ -29,7 +32,7 @@ type TrackingTask struct {
 	spreadsheetID string // spreadsheet where data will be written.
 	// sheet is a exact sheet of spreadsheet. Default is empty, first sheet.
 	sheet         string
-	fn            GetDataFn
+	source        Source
 	direction     Direction
 	withTimestamp bool // if set, timestamp will be written next to written data.
 	// timestampBefore indicates place of timestamp.
@@ -39,6 +42,10 @@ type TrackingTask struct {
 	interval       time.Duration // how often task will be run.
 	// callbacks will be run after whole writting is done.
 	callbacks []Callback
+	// sink is where fetched data is written to. Defaults to the Tracker's
+	// own Google Sheets service when unset, so existing callers that only
+	// know about spreadsheets keep working unchanged.
+	sink Sink
 }
 
 // taskOption is a function that sets TrackingTask fields.
@@ -67,11 +74,20 @@ func WithCallback(c Callback) taskOption {
 	}
 }
 
-// NewTrackingTask returns new instance of TrackingTask.
-func NewTrackingTask(spreadshetID string, direction Direction, interval time.Duration, fn GetDataFn, opts ...taskOption) TrackingTask {
+// WithSink sets the Sink data fetched by this task is written to, instead of
+// the Tracker's default Google Sheets service.
+func WithSink(s Sink) taskOption {
+	return func(tt *TrackingTask) {
+		tt.sink = s
+	}
+}
+
+// NewTrackingTask returns new instance of TrackingTask backed by src. Plain
+// GetDataFn closures can still be used via SourceFromGetDataFn(fn).
+func NewTrackingTask(spreadshetID string, direction Direction, interval time.Duration, src Source, opts ...taskOption) TrackingTask {
 	tt := &TrackingTask{
 		spreadsheetID: spreadshetID,
-		fn:            fn,
+		source:        src,
 		direction:     direction,
 		interval:      interval,
 	}
@@ -87,9 +103,10 @@ type wrappedGetDataFn func(ctx context.Context) error
 // Tracker is a wrapper for the Google Sheets API.
 // It is used to track various kind of things and keep that data in a Google Sheet.
 type Tracker struct {
-	srv   *sheets.Service
-	log   *log.Logger
-	tasks []TrackingTask
+	srv     *sheets.Service
+	log     *log.Logger
+	tasks   []TrackingTask
+	streams []StreamingTask
 }
 
 // NewTracker creates new instance of Tracker.
@@ -105,59 +122,52 @@ func (t *Tracker) AddTrackingFn(tt TrackingTask) {
 	t.tasks = append(t.tasks, tt)
 }
 
-// wrapWithSheetsService wraps TrackinTask data into single function that finds place to write
-// data from TrackingTask and writes it.
-func (t *Tracker) wrapWithSheetsService(task TrackingTask) wrappedGetDataFn {
+// wrapTask wraps TrackingTask data into a single function that fetches the
+// task's data and hands it to its Sink (defaulting to the Tracker's own
+// Google Sheets service) to be written out. The first call also writes the
+// task's Source.Schema() as a header row, if the Source declares one.
+func (t *Tracker) wrapTask(task TrackingTask) wrappedGetDataFn {
 	runCallbacks := func(err error) {
 		for _, cb := range task.callbacks {
 			cb(err)
 		}
 	}
 
-	return func(ctx context.Context) (err error) {
-		defer runCallbacks(err)
+	sink := task.sink
+	if sink == nil {
+		sink = NewSheetsSink(t.srv)
+	}
 
-		data, err := task.fn(ctx)
-		if err != nil {
-			return err
+	headerWritten := false
+
+	return func(ctx context.Context) (err error) {
+		defer func() { runCallbacks(err) }()
+
+		if !headerWritten {
+			headerWritten = true
+			if schema := task.source.Schema(); len(schema) > 0 {
+				if err := sink.Write(ctx, task, TrackedData{schema}); err != nil {
+					return err
+				}
+			}
 		}
 
-		range_ := AddSheetToRange(task.sheet, fmt.Sprintf("%s:%s", task.direction, task.direction))
-		resp, err := t.srv.Spreadsheets.Values.Get(task.spreadsheetID, range_).Do()
+		data, err := task.source.Fetch(ctx)
 		if err != nil {
 			return err
 		}
 
-		// TODO: support column write for now.
-		elementLen := len(resp.Values)
-		// dataLen := len(data)
-
-		t1 := time.Now().String()
-
-		var vr sheets.ValueRange
-		for _, dataPoint := range data {
-			values := []interface{}{dataPoint}
-			if task.withTimestamp {
-				values = append(values, t1)
-			}
-			vr.Values = append(vr.Values, values)
-		}
-
-		range_ = AddSheetToRange(task.sheet, fmt.Sprintf("%s%d", task.direction, elementLen+1))
-		_, err = t.srv.Spreadsheets.Values.
-			Update(task.spreadsheetID, range_, &vr).
-			ValueInputOption("RAW").
-			Context(ctx).
-			Do()
-
-		return err
+		return sink.Write(ctx, task, data)
 	}
 }
 
-// Start stars running all tasks.
+// Start stars running all tasks and streams.
 func (t *Tracker) Start(ctx context.Context) {
 	for _, task := range t.tasks {
-		go runTask(ctx, task.interval, t.wrapWithSheetsService(task))
+		go runTask(ctx, task.interval, t.wrapTask(task))
+	}
+	for _, st := range t.streams {
+		go t.runStreamingTask(ctx, st)
 	}
 }
 